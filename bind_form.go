@@ -0,0 +1,195 @@
+package hack
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type bindConfig struct {
+	tagKey     string
+	timeLayout string
+}
+
+// BindOption - configures the behaviour of BindForm.
+type BindOption func(*bindConfig)
+
+// WithFormTagKey - overrides the struct tag key used to look up a field's
+// form name (defaults to `form`).
+func WithFormTagKey(key string) BindOption {
+	return func(c *bindConfig) {
+		c.tagKey = key
+	}
+}
+
+// WithTimeLayout - overrides the layout used to parse time.Time fields
+// (defaults to time.RFC3339).
+func WithTimeLayout(layout string) BindOption {
+	return func(c *bindConfig) {
+		c.timeLayout = layout
+	}
+}
+
+// FieldBindError - describes why a single field failed to bind.
+type FieldBindError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldBindError) Error() string {
+	return fmt.Sprintf(`field "%s": %s`, e.Field, e.Err)
+}
+
+func (e *FieldBindError) Unwrap() error {
+	return e.Err
+}
+
+// BindErrors - collects every field that failed to bind during a single
+// BindForm call.
+type BindErrors []*FieldBindError
+
+func (e BindErrors) Error() string {
+	parts := make([]string, len(e))
+	for i, fieldErr := range e {
+		parts[i] = fieldErr.Error()
+	}
+	return strings.Join(parts, "; ")
+}
+
+// BindForm - walks `target`'s fields and, for each one, looks up its form
+// name (the field name by default, or the tag set by WithFormTagKey, `form`
+// by default) in `form`, parses the matching value(s) into the field's Go
+// type, and assigns it through SetField. Supports bool, all int/uint widths,
+// float32/64, string, time.Time (layout set by WithTimeLayout) and slices of
+// those, reusing derefStruct/makeSettable so private fields bind too. Rather
+// than stopping at the first failure, every field that fails to parse or
+// assign is collected into a BindErrors. Return error if:
+// (1) - `target` type is not a pointer to struct or
+// interface with underlying type of it.
+// (2) - one or more fields failed to parse or assign (a BindErrors).
+func BindForm(target interface{}, form map[string][]string, opts ...BindOption) error {
+
+	targetValue, err := derefStruct(target)
+	if err != nil {
+		return err
+	}
+
+	cfg := &bindConfig{tagKey: "form", timeLayout: time.RFC3339}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	typ := targetValue.Type()
+
+	var errs BindErrors
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		structField := typ.Field(i)
+
+		name := formFieldName(structField, cfg.tagKey)
+		if name == "-" {
+			continue
+		}
+
+		values, ok := form[name]
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		fieldValue := targetValue.Field(i)
+
+		parsed, err := parseFormValue(fieldValue.Type(), values, cfg)
+		if err != nil {
+			errs = append(errs, &FieldBindError{Field: structField.Name, Err: err})
+			continue
+		}
+
+		if err := SetField(target, structField.Name, parsed); err != nil {
+			errs = append(errs, &FieldBindError{Field: structField.Name, Err: err})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func formFieldName(structField reflect.StructField, tagKey string) string {
+
+	name, _, skip := splitTag(structField, tagKey)
+	if skip {
+		return "-"
+	}
+
+	return name
+}
+
+func parseFormValue(fieldType reflect.Type, values []string, cfg *bindConfig) (interface{}, error) {
+
+	if fieldType.Kind() == reflect.Slice {
+		elemType := fieldType.Elem()
+		result := reflect.MakeSlice(fieldType, len(values), len(values))
+
+		for i, raw := range values {
+			elem, err := parseFormScalar(elemType, raw, cfg)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			result.Index(i).Set(reflect.ValueOf(elem))
+		}
+
+		return result.Interface(), nil
+	}
+
+	return parseFormScalar(fieldType, values[0], cfg)
+}
+
+func parseFormScalar(typ reflect.Type, raw string, cfg *bindConfig) (interface{}, error) {
+
+	if typ == reflect.TypeOf(time.Time{}) {
+		return time.Parse(cfg.timeLayout, raw)
+	}
+
+	return parseFormPrimitive(typ, raw)
+}
+
+func parseFormPrimitive(typ reflect.Type, raw string) (interface{}, error) {
+
+	switch typ.Kind() {
+
+	case reflect.String:
+		return raw, nil
+
+	case reflect.Bool:
+		return strconv.ParseBool(raw)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(raw, 10, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(v).Convert(typ).Interface(), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(raw, 10, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(v).Convert(typ).Interface(), nil
+
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(raw, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(v).Convert(typ).Interface(), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported field kind `%s`", typ.Kind())
+	}
+}
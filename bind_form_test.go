@@ -0,0 +1,70 @@
+package hack
+
+import (
+	"testing"
+	"time"
+)
+
+type bindEvent struct {
+	Name  string `form:"name"`
+	Score int
+	Dates []time.Time
+	When  time.Time
+}
+
+func TestBindFormPrimitivesAndTimeSlice(t *testing.T) {
+	form := map[string][]string{
+		"name":  {"party"},
+		"Score": {"42"},
+		"Dates": {"2026-07-29T00:00:00Z", "2026-08-01T00:00:00Z"},
+		"When":  {"2026-07-29T00:00:00Z"},
+	}
+
+	var e bindEvent
+	if err := BindForm(&e, form); err != nil {
+		t.Fatalf("BindForm returned error: %v", err)
+	}
+
+	if e.Name != "party" {
+		t.Errorf(`expected Name == "party", got %q`, e.Name)
+	}
+	if e.Score != 42 {
+		t.Errorf("expected Score == 42, got %d", e.Score)
+	}
+	if len(e.Dates) != 2 {
+		t.Fatalf("expected 2 Dates, got %d", len(e.Dates))
+	}
+	want := time.Date(2026, 7, 29, 0, 0, 0, 0, time.UTC)
+	if !e.Dates[0].Equal(want) {
+		t.Errorf("expected Dates[0] == %v, got %v", want, e.Dates[0])
+	}
+	if !e.When.Equal(want) {
+		t.Errorf("expected When == %v, got %v", want, e.When)
+	}
+}
+
+func TestBindFormCollectsMultipleFieldErrors(t *testing.T) {
+	form := map[string][]string{
+		"Score": {"not-a-number"},
+		"Flag":  {"not-a-bool"},
+	}
+
+	type multi struct {
+		Score int
+		Flag  bool
+	}
+
+	var m multi
+	err := BindForm(&m, form)
+	if err == nil {
+		t.Fatal("expected BindForm to return an error")
+	}
+
+	bindErrs, ok := err.(BindErrors)
+	if !ok {
+		t.Fatalf("expected BindErrors, got %T", err)
+	}
+	if len(bindErrs) != 2 {
+		t.Errorf("expected both fields to be reported, got %d: %v", len(bindErrs), bindErrs)
+	}
+}
@@ -0,0 +1,157 @@
+package hack
+
+import (
+	"reflect"
+)
+
+type ptrKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// Clone - returns a fully independent copy of `src`, following pointers and
+// duplicating slices and maps along the way. Recursive reference cycles are
+// detected and preserved in the clone, return error if:
+// (1) - `src` type is not a pointer to struct or
+// interface with underlying type of it.
+func Clone(src interface{}) (interface{}, error) {
+
+	if _, err := derefStruct(src); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[ptrKey]reflect.Value)
+
+	// Clone from the root pointer itself, not the dereferenced struct, so
+	// the root's own address is registered in `seen` before recursing into
+	// its fields: this is what lets a cycle looping back to the root (e.g.
+	// `n.Next = n`) be detected rather than duplicated.
+	cloned, err := cloneValue(reflect.ValueOf(src), seen)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloned.Interface(), nil
+}
+
+func cloneValue(src reflect.Value, seen map[ptrKey]reflect.Value) (reflect.Value, error) {
+
+	switch src.Kind() {
+
+	case reflect.Ptr:
+		if src.IsNil() {
+			return reflect.Zero(src.Type()), nil
+		}
+
+		key := ptrKey{addr: src.Pointer(), typ: src.Type()}
+		if existing, ok := seen[key]; ok {
+			return existing, nil
+		}
+
+		newPtr := reflect.New(src.Type().Elem())
+		seen[key] = newPtr
+
+		elemClone, err := cloneValue(src.Elem(), seen)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		newPtr.Elem().Set(elemClone)
+
+		return newPtr, nil
+
+	case reflect.Struct:
+		// struct values held in maps or interfaces are not addressable, so
+		// read their fields from an addressable copy.
+		if !src.CanAddr() {
+			addressable := reflect.New(src.Type()).Elem()
+			addressable.Set(src)
+			src = addressable
+		}
+
+		dst := reflect.New(src.Type()).Elem()
+
+		for i := 0; i < src.NumField(); i++ {
+			fieldSrc := src.Field(i)
+			makeSettable(&fieldSrc)
+
+			fieldDst := dst.Field(i)
+			makeSettable(&fieldDst)
+
+			fieldClone, err := cloneValue(fieldSrc, seen)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			fieldDst.Set(fieldClone)
+		}
+
+		return dst, nil
+
+	case reflect.Slice:
+		if src.IsNil() {
+			return reflect.Zero(src.Type()), nil
+		}
+
+		dst := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			elemClone, err := cloneValue(src.Index(i), seen)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			dst.Index(i).Set(elemClone)
+		}
+
+		return dst, nil
+
+	case reflect.Array:
+		dst := reflect.New(src.Type()).Elem()
+		for i := 0; i < src.Len(); i++ {
+			elemClone, err := cloneValue(src.Index(i), seen)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			dst.Index(i).Set(elemClone)
+		}
+
+		return dst, nil
+
+	case reflect.Map:
+		if src.IsNil() {
+			return reflect.Zero(src.Type()), nil
+		}
+
+		dst := reflect.MakeMapWithSize(src.Type(), src.Len())
+		for _, key := range src.MapKeys() {
+			keyClone, err := cloneValue(key, seen)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			valClone, err := cloneValue(src.MapIndex(key), seen)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			dst.SetMapIndex(keyClone, valClone)
+		}
+
+		return dst, nil
+
+	case reflect.Interface:
+		if src.IsNil() {
+			return reflect.Zero(src.Type()), nil
+		}
+
+		elemClone, err := cloneValue(src.Elem(), seen)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(elemClone)
+
+		return dst, nil
+
+	default:
+		return src, nil
+	}
+}
@@ -0,0 +1,84 @@
+package hack
+
+import "testing"
+
+type cloneAddress struct {
+	Street string
+}
+
+type cloneUser struct {
+	Name string
+	Addr *cloneAddress
+	Tags []string
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	u := cloneUser{Name: "bob", Addr: &cloneAddress{Street: "Main"}, Tags: []string{"a"}}
+
+	clonedIface, err := Clone(&u)
+	if err != nil {
+		t.Fatalf("Clone returned error: %v", err)
+	}
+
+	cloned, ok := clonedIface.(*cloneUser)
+	if !ok {
+		t.Fatalf("expected *cloneUser, got %T", clonedIface)
+	}
+
+	cloned.Name = "alice"
+	cloned.Addr.Street = "Second"
+	cloned.Tags[0] = "z"
+
+	if u.Name != "bob" {
+		t.Errorf("expected original Name to be unchanged, got %q", u.Name)
+	}
+	if u.Addr.Street != "Main" {
+		t.Errorf("expected original Addr.Street to be unchanged, got %q", u.Addr.Street)
+	}
+	if u.Tags[0] != "a" {
+		t.Errorf("expected original Tags to be unchanged, got %v", u.Tags)
+	}
+}
+
+type cloneNode struct {
+	Name string
+	Next *cloneNode
+}
+
+func TestCloneSelfLoopPreservesCycle(t *testing.T) {
+	n := &cloneNode{Name: "n"}
+	n.Next = n
+
+	clonedIface, err := Clone(n)
+	if err != nil {
+		t.Fatalf("Clone returned error: %v", err)
+	}
+
+	cloned := clonedIface.(*cloneNode)
+	if cloned == n {
+		t.Fatalf("expected clone to be a distinct pointer")
+	}
+	if cloned.Next != cloned {
+		t.Errorf("expected the self-loop to point back at the clone, got %p", cloned.Next)
+	}
+}
+
+func TestCloneTwoNodeCyclePreserved(t *testing.T) {
+	a := &cloneNode{Name: "a"}
+	b := &cloneNode{Name: "b"}
+	a.Next = b
+	b.Next = a
+
+	clonedIface, err := Clone(a)
+	if err != nil {
+		t.Fatalf("Clone returned error: %v", err)
+	}
+
+	clonedA := clonedIface.(*cloneNode)
+	if clonedA == a || clonedA.Next == b {
+		t.Fatalf("expected a fully independent clone")
+	}
+	if clonedA.Next.Next != clonedA {
+		t.Errorf("expected the two-node cycle to be preserved in the clone")
+	}
+}
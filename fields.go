@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"unsafe"
 )
 
@@ -117,10 +118,92 @@ func setZeroValue(v *reflect.Value) {
 	v.Set(zeroValue)
 }
 
+// splitTag - parses a `key:"name,opt1,opt2"`-style struct tag looked up
+// under `tagKey`, returning the field's effective name (falling back to its
+// Go name when the tag is absent or has no name segment) and any remaining
+// comma-separated options. skip reports a `key:"-"` tag.
+func splitTag(structField reflect.StructField, tagKey string) (name string, opts []string, skip bool) {
+
+	tag, ok := structField.Tag.Lookup(tagKey)
+	if !ok {
+		return structField.Name, nil, false
+	}
+
+	if tag == "-" {
+		return "", nil, true
+	}
+
+	parts := strings.Split(tag, ",")
+
+	name = structField.Name
+	if parts[0] != "" {
+		name = parts[0]
+	}
+
+	return name, parts[1:], false
+}
+
 // Field - info about struct field
 type Field struct {
 	Name  string
 	Value interface{}
+
+	// StructField is the underlying reflect.StructField, giving access to
+	// Tag, Anonymous, PkgPath and other struct-level metadata.
+	StructField reflect.StructField
+}
+
+// Tag - returns the value associated with `key` in the field's struct tag,
+// or an empty string if the tag or key is not present.
+func (f Field) Tag(key string) string {
+	return f.StructField.Tag.Get(key)
+}
+
+// IsEmbedded - reports whether the field is an embedded (anonymous) field.
+func (f Field) IsEmbedded() bool {
+	return f.StructField.Anonymous
+}
+
+// IsExported - reports whether the field is exported.
+func (f Field) IsExported() bool {
+	return f.StructField.PkgPath == ""
+}
+
+// IsZero - reports whether the field holds the zero value for its type.
+func (f Field) IsZero() bool {
+	value := reflect.ValueOf(f.Value)
+	if !value.IsValid() {
+		return true
+	}
+	return value.IsZero()
+}
+
+// Fields - returns metadata for all struct fields in natural order,
+// return error if:
+// (1) - `target` type is not a pointer to struct or
+// interface with underlying type of it.
+func Fields(target interface{}) ([]Field, error) {
+
+	targetValue, err := derefStruct(target)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]Field, 0, targetValue.NumField())
+
+	for i := 0; i < targetValue.NumField(); i++ {
+
+		fieldValue := targetValue.Field(i)
+		makeSettable(&fieldValue)
+
+		fields = append(fields, Field{
+			Name:        targetValue.Type().Field(i).Name,
+			Value:       fieldValue.Interface(),
+			StructField: targetValue.Type().Field(i),
+		})
+	}
+
+	return fields, nil
 }
 
 // Transform - applies to fn to all struct fields in natural order
@@ -140,8 +223,9 @@ func Transform(
 		makeSettable(&fieldValue)
 
 		field := Field{
-			Name:  targetValue.Type().Field(i).Name,
-			Value: fieldValue.Interface(),
+			Name:        targetValue.Type().Field(i).Name,
+			Value:       fieldValue.Interface(),
+			StructField: targetValue.Type().Field(i),
 		}
 
 		update, value := fn(field)
@@ -180,3 +264,69 @@ func Transform(
 
 	return nil
 }
+
+// TransformByTag - applies fn only to struct fields carrying `tagKey` in
+// their struct tag, in natural order.
+func TransformByTag(
+	target interface{},
+	tagKey string,
+	fn func(Field) (bool, interface{}),
+) error {
+
+	targetValue, err := derefStruct(target)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < targetValue.NumField(); i++ {
+
+		structField := targetValue.Type().Field(i)
+		if _, ok := structField.Tag.Lookup(tagKey); !ok {
+			continue
+		}
+
+		fieldValue := targetValue.Field(i)
+		makeSettable(&fieldValue)
+
+		field := Field{
+			Name:        structField.Name,
+			Value:       fieldValue.Interface(),
+			StructField: structField,
+		}
+
+		update, value := fn(field)
+		if !update {
+			continue
+		}
+
+		if value == nil {
+			switch fieldValue.Kind() {
+			case reflect.Ptr, reflect.Chan, reflect.Map, reflect.Slice, reflect.Func, reflect.Interface:
+				setZeroValue(&fieldValue)
+				continue
+			default:
+				return fmt.Errorf(
+					`"nil" is not assignable to "%s"`,
+					fieldValue.Type(),
+				)
+			}
+		}
+
+		valueType := reflect.ValueOf(value).Type()
+		fieldType := fieldValue.Type()
+		if !valueType.AssignableTo(fieldType) {
+			return fmt.Errorf(
+				`update field "%s" faild: "%s" is not assignable to "%s"`,
+				field.Name,
+				valueType,
+				fieldType,
+			)
+		}
+
+		fieldValue.Set(
+			reflect.ValueOf(value),
+		)
+	}
+
+	return nil
+}
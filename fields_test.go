@@ -0,0 +1,88 @@
+package hack
+
+import "testing"
+
+type taggedUser struct {
+	Name   string `secret:"yes"`
+	Email  string `secret:"yes"`
+	Age    int
+	parent *taggedUser
+}
+
+func TestFields(t *testing.T) {
+	u := taggedUser{Name: "bob", Age: 30}
+
+	fields, err := Fields(&u)
+	if err != nil {
+		t.Fatalf("Fields returned error: %v", err)
+	}
+	if len(fields) != 4 {
+		t.Fatalf("expected 4 fields, got %d", len(fields))
+	}
+
+	nameField := fields[0]
+	if nameField.Tag("secret") != "yes" {
+		t.Errorf(`expected Tag("secret") == "yes", got %q`, nameField.Tag("secret"))
+	}
+	if !nameField.IsExported() {
+		t.Errorf("expected Name to be exported")
+	}
+
+	parentField := fields[3]
+	if parentField.IsExported() {
+		t.Errorf("expected parent to be unexported")
+	}
+	if !parentField.IsZero() {
+		t.Errorf("expected parent to be zero")
+	}
+
+	ageField := fields[2]
+	if ageField.IsZero() {
+		t.Errorf("expected Age to be non-zero")
+	}
+}
+
+func TestTransformByTag(t *testing.T) {
+	u := taggedUser{Name: "bob", Email: "bob@example.com", Age: 30}
+
+	err := TransformByTag(&u, "secret", func(f Field) (bool, interface{}) {
+		return true, "[redacted]"
+	})
+	if err != nil {
+		t.Fatalf("TransformByTag returned error: %v", err)
+	}
+
+	if u.Name != "[redacted]" {
+		t.Errorf("expected Name to be redacted, got %q", u.Name)
+	}
+	if u.Email != "[redacted]" {
+		t.Errorf("expected Email to be redacted, got %q", u.Email)
+	}
+	if u.Age != 30 {
+		t.Errorf("expected untagged Age to be left alone, got %d", u.Age)
+	}
+}
+
+type redactablePair struct {
+	A *string `secret:"yes"`
+	B *string `secret:"yes"`
+}
+
+func TestTransformByTagNilsAllTaggedFields(t *testing.T) {
+	a, b := "a", "b"
+	pair := redactablePair{A: &a, B: &b}
+
+	err := TransformByTag(&pair, "secret", func(f Field) (bool, interface{}) {
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("TransformByTag returned error: %v", err)
+	}
+
+	if pair.A != nil {
+		t.Errorf("expected A to be zeroed")
+	}
+	if pair.B != nil {
+		t.Errorf("expected B to be zeroed, early-return regression")
+	}
+}
@@ -0,0 +1,274 @@
+package hack
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type mapConfig struct {
+	tagKey  string
+	convert bool
+}
+
+// MapOption - configures the behaviour of ToMap and FromMap.
+type MapOption func(*mapConfig)
+
+// WithTagKey - overrides the struct tag key used for field renaming and
+// `omitempty` control (defaults to `hack`).
+func WithTagKey(key string) MapOption {
+	return func(c *mapConfig) {
+		c.tagKey = key
+	}
+}
+
+// WithConvert - allows FromMap to coerce values into a field's type via
+// reflect.Value.Convert when they aren't directly assignable.
+func WithConvert() MapOption {
+	return func(c *mapConfig) {
+		c.convert = true
+	}
+}
+
+// ToMap - walks `target`'s fields and returns them as a map[string]interface{},
+// recursing into nested and embedded structs. Field names can be overridden
+// with a `hack:"name"` tag (or the key set by WithTagKey); `hack:"-"` skips
+// the field and `hack:",omitempty"` drops it from the result when it holds
+// its zero value. Embedded struct fields flatten into the parent map, other
+// struct fields nest under their own name. Return error if:
+// (1) - `target` type is not a pointer to struct or
+// interface with underlying type of it.
+func ToMap(target interface{}, opts ...MapOption) (map[string]interface{}, error) {
+
+	targetValue, err := derefStruct(target)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &mapConfig{tagKey: "hack"}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return structToMap(*targetValue, cfg)
+}
+
+func structToMap(v reflect.Value, cfg *mapConfig) (map[string]interface{}, error) {
+
+	result := make(map[string]interface{})
+	typ := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+
+		fieldValue := v.Field(i)
+		makeSettable(&fieldValue)
+
+		structField := typ.Field(i)
+
+		name, omitempty, skip := parseMapTag(structField, cfg.tagKey)
+		if skip {
+			continue
+		}
+
+		if structField.Anonymous {
+			if nested, ok, err := flattenEmbedded(fieldValue, cfg); err != nil {
+				return nil, err
+			} else if ok {
+				for k, v := range nested {
+					result[k] = v
+				}
+				continue
+			}
+		}
+
+		value, err := fieldToMapValue(fieldValue, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if omitempty && fieldValue.IsZero() {
+			continue
+		}
+
+		result[name] = value
+	}
+
+	return result, nil
+}
+
+func flattenEmbedded(fieldValue reflect.Value, cfg *mapConfig) (map[string]interface{}, bool, error) {
+
+	v := fieldValue
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, true, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, false, nil
+	}
+
+	nested, err := structToMap(v, cfg)
+	return nested, true, err
+}
+
+func fieldToMapValue(fieldValue reflect.Value, cfg *mapConfig) (interface{}, error) {
+
+	v := fieldValue
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Struct {
+		return structToMap(v, cfg)
+	}
+
+	return fieldValue.Interface(), nil
+}
+
+// FromMap - performs the inverse of ToMap, assigning values from `m` into
+// `target`'s fields by name (honoring the same tag as ToMap), recursing into
+// nested maps for nested structs and reading embedded structs' fields from
+// `m` itself, as ToMap flattens them. By default a value must be
+// directly assignable to its field; WithConvert additionally allows
+// convertible types to be coerced. Return error if:
+// (1) - `target` type is not a pointer to struct or
+// interface with underlying type of it.
+// (2) - a value in `m` is not assignable (or convertible, with WithConvert)
+// to its matching field.
+func FromMap(target interface{}, m map[string]interface{}, opts ...MapOption) error {
+
+	targetValue, err := derefStruct(target)
+	if err != nil {
+		return err
+	}
+
+	cfg := &mapConfig{tagKey: "hack"}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return mapToStruct(*targetValue, m, cfg)
+}
+
+func mapToStruct(v reflect.Value, m map[string]interface{}, cfg *mapConfig) error {
+
+	typ := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+
+		fieldValue := v.Field(i)
+		makeSettable(&fieldValue)
+
+		structField := typ.Field(i)
+
+		name, _, skip := parseMapTag(structField, cfg.tagKey)
+		if skip {
+			continue
+		}
+
+		if structField.Anonymous {
+			nestedStruct, err := nestedStructFor(fieldValue)
+			if err != nil {
+				return err
+			}
+			if nestedStruct.IsValid() {
+				if err := mapToStruct(nestedStruct, m, cfg); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		raw, ok := m[name]
+		if !ok {
+			continue
+		}
+
+		if err := assignMapValue(fieldValue, structField, raw, cfg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func nestedStructFor(fieldValue reflect.Value) (reflect.Value, error) {
+
+	v := fieldValue
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, nil
+	}
+
+	return v, nil
+}
+
+func assignMapValue(fieldValue reflect.Value, structField reflect.StructField, raw interface{}, cfg *mapConfig) error {
+
+	if raw == nil {
+		switch fieldValue.Kind() {
+		case reflect.Ptr, reflect.Chan, reflect.Map, reflect.Slice, reflect.Func, reflect.Interface:
+			setZeroValue(&fieldValue)
+			return nil
+		default:
+			return fmt.Errorf(`"nil" is not assignable to "%s"`, fieldValue.Type())
+		}
+	}
+
+	if nested, ok := raw.(map[string]interface{}); ok {
+		nestedStruct, err := nestedStructFor(fieldValue)
+		if err != nil {
+			return err
+		}
+		if nestedStruct.IsValid() {
+			return mapToStruct(nestedStruct, nested, cfg)
+		}
+	}
+
+	rawValue := reflect.ValueOf(raw)
+	fieldType := fieldValue.Type()
+
+	if rawValue.Type().AssignableTo(fieldType) {
+		fieldValue.Set(rawValue)
+		return nil
+	}
+
+	if cfg.convert && rawValue.Type().ConvertibleTo(fieldType) {
+		fieldValue.Set(rawValue.Convert(fieldType))
+		return nil
+	}
+
+	return fmt.Errorf(
+		`field "%s": "%s" is not assignable to "%s"`,
+		structField.Name,
+		rawValue.Type(),
+		fieldType,
+	)
+}
+
+func parseMapTag(structField reflect.StructField, tagKey string) (name string, omitempty bool, skip bool) {
+
+	name, opts, skip := splitTag(structField, tagKey)
+	if skip {
+		return "", false, true
+	}
+
+	for _, opt := range opts {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+
+	return name, omitempty, false
+}
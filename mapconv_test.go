@@ -0,0 +1,74 @@
+package hack
+
+import "testing"
+
+type mapBase struct {
+	ID int
+}
+
+type mapUser struct {
+	mapBase
+	Name     string `hack:"name"`
+	Internal string `hack:"-"`
+	Note     string `hack:",omitempty"`
+}
+
+func TestToMapRenamesSkipsAndOmitsEmpty(t *testing.T) {
+	u := mapUser{mapBase: mapBase{ID: 1}, Name: "bob", Internal: "secret"}
+
+	m, err := ToMap(&u)
+	if err != nil {
+		t.Fatalf("ToMap returned error: %v", err)
+	}
+
+	if m["name"] != "bob" {
+		t.Errorf(`expected m["name"] == "bob", got %v`, m["name"])
+	}
+	if _, ok := m["Internal"]; ok {
+		t.Errorf(`expected "Internal" to be skipped via hack:"-"`)
+	}
+	if _, ok := m["Note"]; ok {
+		t.Errorf(`expected empty "Note" to be omitted`)
+	}
+	if m["ID"] != 1 {
+		t.Errorf("expected embedded mapBase.ID to flatten into the result, got %v", m["ID"])
+	}
+}
+
+func TestFromMapRoundTrip(t *testing.T) {
+	u := mapUser{mapBase: mapBase{ID: 2}, Name: "alice", Note: "hi"}
+
+	m, err := ToMap(&u)
+	if err != nil {
+		t.Fatalf("ToMap returned error: %v", err)
+	}
+
+	var out mapUser
+	if err := FromMap(&out, m); err != nil {
+		t.Fatalf("FromMap returned error: %v", err)
+	}
+
+	if out.Name != "alice" || out.ID != 2 || out.Note != "hi" {
+		t.Errorf("expected round-trip to reproduce the original, got %+v", out)
+	}
+}
+
+type mapConvertible struct {
+	Count int64
+}
+
+func TestFromMapWithConvertCoercesTypes(t *testing.T) {
+	m := map[string]interface{}{"Count": int(5)}
+
+	var out mapConvertible
+	if err := FromMap(&out, m); err == nil {
+		t.Fatalf("expected FromMap without WithConvert to reject int -> int64")
+	}
+
+	if err := FromMap(&out, m, WithConvert()); err != nil {
+		t.Fatalf("FromMap with WithConvert returned error: %v", err)
+	}
+	if out.Count != 5 {
+		t.Errorf("expected Count to be coerced to 5, got %d", out.Count)
+	}
+}
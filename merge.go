@@ -0,0 +1,198 @@
+package hack
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SliceStrategy - controls how Merge combines slice fields.
+type SliceStrategy int
+
+const (
+	// SliceAppend - appends `src`'s elements to `dst`'s (default).
+	SliceAppend SliceStrategy = iota
+	// SliceReplace - replaces `dst`'s slice with `src`'s.
+	SliceReplace
+	// SliceUnion - appends only the elements of `src` not already present
+	// in `dst`.
+	SliceUnion
+)
+
+type mergeConfig struct {
+	override      bool
+	sliceStrategy SliceStrategy
+}
+
+// MergeOption - configures the behaviour of Merge.
+type MergeOption func(*mergeConfig)
+
+// WithOverride - makes Merge copy every field from `src` into `dst`
+// unconditionally, instead of only the zero-valued ones.
+func WithOverride() MergeOption {
+	return func(c *mergeConfig) {
+		c.override = true
+	}
+}
+
+// WithSliceStrategy - selects how slice fields are combined.
+func WithSliceStrategy(strategy SliceStrategy) MergeOption {
+	return func(c *mergeConfig) {
+		c.sliceStrategy = strategy
+	}
+}
+
+// Merge - copies fields from `src` into `dst`. By default a field is only
+// copied when its `dst` value is the zero value for its type; WithOverride
+// copies unconditionally. Nested structs are merged recursively. A slice
+// field is only touched when `src`'s slice is non-empty: a nil `dst` slice,
+// or any `dst` slice under WithOverride, is combined with `src`'s according
+// to WithSliceStrategy (append by default); without WithOverride a non-nil
+// `dst` slice, even an empty one, is left alone. Maps are key-merged,
+// with `src` winning on key collisions only under WithOverride.
+// Return error if:
+// (1) - `dst` or `src` type is not a pointer to struct or
+// interface with underlying type of it.
+// (2) - `dst` and `src` are not the same type.
+func Merge(dst, src interface{}, opts ...MergeOption) error {
+
+	dstValue, err := derefStruct(dst)
+	if err != nil {
+		return err
+	}
+
+	srcValue, err := derefStruct(src)
+	if err != nil {
+		return err
+	}
+
+	if dstValue.Type() != srcValue.Type() {
+		return fmt.Errorf(
+			"dst and src must be of the same type, got `%s` and `%s`",
+			dstValue.Type(),
+			srcValue.Type(),
+		)
+	}
+
+	cfg := &mergeConfig{sliceStrategy: SliceAppend}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	// Tracks the `src` pointers currently being descended into (the
+	// ancestor path, not a flat "ever visited" set) so only a genuine cycle
+	// back to an in-progress ancestor is short-circuited; two sibling
+	// fields that merely share a reference to the same acyclic object are
+	// each merged normally.
+	path := make(map[uintptr]bool)
+
+	return mergeStruct(*dstValue, *srcValue, cfg, path)
+}
+
+func mergeStruct(dst, src reflect.Value, cfg *mergeConfig, path map[uintptr]bool) error {
+
+	for i := 0; i < dst.NumField(); i++ {
+		dstField := dst.Field(i)
+		makeSettable(&dstField)
+
+		srcField := src.Field(i)
+		makeSettable(&srcField)
+
+		if err := mergeValue(dstField, srcField, cfg, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func mergeValue(dst, src reflect.Value, cfg *mergeConfig, path map[uintptr]bool) error {
+
+	switch dst.Kind() {
+
+	case reflect.Struct:
+		return mergeStruct(dst, src, cfg, path)
+
+	case reflect.Ptr:
+		if src.IsNil() {
+			return nil
+		}
+
+		addr := src.Pointer()
+		if path[addr] {
+			return nil
+		}
+		path[addr] = true
+		defer delete(path, addr)
+
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		return mergeValue(dst.Elem(), src.Elem(), cfg, path)
+
+	case reflect.Slice:
+		if src.IsNil() || src.Len() == 0 {
+			return nil
+		}
+
+		if !cfg.override && !dst.IsZero() {
+			return nil
+		}
+
+		switch cfg.sliceStrategy {
+		case SliceReplace:
+			dst.Set(src)
+		case SliceUnion:
+			dst.Set(unionSlice(dst, src))
+		default:
+			dst.Set(reflect.AppendSlice(dst, src))
+		}
+
+		return nil
+
+	case reflect.Map:
+		if src.IsNil() {
+			return nil
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMap(dst.Type()))
+		}
+
+		for _, key := range src.MapKeys() {
+			if !cfg.override && dst.MapIndex(key).IsValid() {
+				continue
+			}
+			dst.SetMapIndex(key, src.MapIndex(key))
+		}
+
+		return nil
+
+	default:
+		if cfg.override || dst.IsZero() {
+			dst.Set(src)
+		}
+		return nil
+	}
+}
+
+func unionSlice(dst, src reflect.Value) reflect.Value {
+
+	result := reflect.AppendSlice(reflect.MakeSlice(dst.Type(), 0, dst.Len()+src.Len()), dst)
+
+	for i := 0; i < src.Len(); i++ {
+		elem := src.Index(i)
+
+		found := false
+		for j := 0; j < result.Len(); j++ {
+			if reflect.DeepEqual(result.Index(j).Interface(), elem.Interface()) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = reflect.Append(result, elem)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,88 @@
+package hack
+
+import "testing"
+
+type mergeAddr struct {
+	City string
+}
+
+type mergePerson struct {
+	Home *mergeAddr
+	Work *mergeAddr
+}
+
+type mergeBag struct {
+	Items []string
+}
+
+func TestMergeDefaultOnlyFillsZeroFields(t *testing.T) {
+	dst := &mergeBag{}
+	src := &mergeBag{Items: []string{"incoming"}}
+
+	if err := Merge(dst, src); err != nil {
+		t.Fatalf("Merge returned error: %v", err)
+	}
+	if len(dst.Items) != 1 || dst.Items[0] != "incoming" {
+		t.Errorf("expected zero-valued dst.Items to be filled, got %v", dst.Items)
+	}
+}
+
+func TestMergeDefaultLeavesNonZeroSliceAlone(t *testing.T) {
+	dst := &mergeBag{Items: []string{"keep"}}
+	src := &mergeBag{Items: []string{"incoming"}}
+
+	if err := Merge(dst, src); err != nil {
+		t.Fatalf("Merge returned error: %v", err)
+	}
+	if len(dst.Items) != 1 || dst.Items[0] != "keep" {
+		t.Errorf("expected non-zero dst.Items to be left alone, got %v", dst.Items)
+	}
+}
+
+func TestMergeOverrideCombinesSlicesByStrategy(t *testing.T) {
+	dst := &mergeBag{Items: []string{"keep"}}
+	src := &mergeBag{Items: []string{"incoming"}}
+
+	if err := Merge(dst, src, WithOverride()); err != nil {
+		t.Fatalf("Merge returned error: %v", err)
+	}
+	if len(dst.Items) != 2 || dst.Items[0] != "keep" || dst.Items[1] != "incoming" {
+		t.Errorf("expected override to append src onto dst, got %v", dst.Items)
+	}
+}
+
+func TestMergeSharedAcyclicPointerMergesBothFields(t *testing.T) {
+	shared := &mergeAddr{City: "LA"}
+	dst := &mergePerson{}
+	src := &mergePerson{Home: shared, Work: shared}
+
+	if err := Merge(dst, src); err != nil {
+		t.Fatalf("Merge returned error: %v", err)
+	}
+	if dst.Home == nil || dst.Home.City != "LA" {
+		t.Errorf("expected Home to be merged, got %+v", dst.Home)
+	}
+	if dst.Work == nil || dst.Work.City != "LA" {
+		t.Errorf("expected Work to be merged too, got %+v", dst.Work)
+	}
+}
+
+type mergeNode struct {
+	Name string
+	Next *mergeNode
+}
+
+func TestMergeHandlesPointerCycles(t *testing.T) {
+	dst := &mergeNode{Name: "dst"}
+	dst.Next = dst
+
+	src := &mergeNode{Name: "src"}
+	src.Next = src
+
+	if err := Merge(dst, src); err != nil {
+		t.Fatalf("Merge returned error: %v", err)
+	}
+	if dst.Name != "dst" {
+		t.Errorf("expected zero-valued rule to leave Name alone, got %q", dst.Name)
+	}
+}
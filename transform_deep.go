@@ -0,0 +1,205 @@
+package hack
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// TransformDeep - recursively applies fn to every leaf field reachable from
+// `target`, descending into embedded/anonymous fields, pointer-to-struct
+// fields, slice/array elements and map values. `path` identifies the leaf
+// relative to `target`, e.g. `["User", "Address", "Street"]` for a nested
+// struct field or `["Tags", "0"]` for a slice element.
+//
+// Pointers already visited during the current call are tracked to guard
+// against cycles; fields are visited in natural order.
+func TransformDeep(
+	target interface{},
+	fn func(path []string, f Field) (bool, interface{}),
+) error {
+
+	targetValue, err := derefStruct(target)
+	if err != nil {
+		return err
+	}
+
+	visited := make(map[uintptr]bool)
+
+	return transformDeepStruct(nil, *targetValue, visited, fn)
+}
+
+func transformDeepStruct(
+	path []string,
+	v reflect.Value,
+	visited map[uintptr]bool,
+	fn func([]string, Field) (bool, interface{}),
+) error {
+
+	typ := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+
+		fieldValue := v.Field(i)
+		makeSettable(&fieldValue)
+
+		structField := typ.Field(i)
+
+		fieldPath := path
+		if !structField.Anonymous {
+			fieldPath = appendPath(path, structField.Name)
+		}
+
+		if err := transformDeepValue(fieldPath, fieldValue, structField, visited, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func transformDeepValue(
+	path []string,
+	v reflect.Value,
+	structField reflect.StructField,
+	visited map[uintptr]bool,
+	fn func([]string, Field) (bool, interface{}),
+) error {
+
+	switch v.Kind() {
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+
+		addr := v.Pointer()
+		if visited[addr] {
+			return nil
+		}
+		visited[addr] = true
+
+		return transformDeepValue(path, v.Elem(), structField, visited, fn)
+
+	case reflect.Struct:
+		return transformDeepStruct(path, v, visited, fn)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			elemValue := v.Index(i)
+			makeSettable(&elemValue)
+
+			elemPath := appendPath(path, strconv.Itoa(i))
+			if err := transformDeepValue(elemPath, elemValue, reflect.StructField{}, visited, fn); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Map:
+		return transformDeepMap(path, v, visited, fn)
+
+	default:
+		field := Field{
+			Name:        structField.Name,
+			Value:       v.Interface(),
+			StructField: structField,
+		}
+
+		update, value := fn(path, field)
+		if !update {
+			return nil
+		}
+
+		if value == nil {
+			switch v.Kind() {
+			case reflect.Ptr, reflect.Chan, reflect.Map, reflect.Slice, reflect.Func, reflect.Interface:
+				setZeroValue(&v)
+				return nil
+			default:
+				return fmt.Errorf(
+					`"nil" is not assignable to "%s"`,
+					v.Type(),
+				)
+			}
+		}
+
+		valueType := reflect.ValueOf(value).Type()
+		if !valueType.AssignableTo(v.Type()) {
+			return fmt.Errorf(
+				`update field "%s" faild: "%s" is not assignable to "%s"`,
+				field.Name,
+				valueType,
+				v.Type(),
+			)
+		}
+
+		v.Set(reflect.ValueOf(value))
+
+		return nil
+	}
+}
+
+func transformDeepMap(
+	path []string,
+	v reflect.Value,
+	visited map[uintptr]bool,
+	fn func([]string, Field) (bool, interface{}),
+) error {
+
+	for _, key := range v.MapKeys() {
+
+		elemValue := v.MapIndex(key)
+		elemPath := appendPath(path, fmt.Sprint(key.Interface()))
+
+		switch elemValue.Kind() {
+		case reflect.Struct, reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			// map values are not addressable, so recurse into a settable
+			// copy and write it back once the traversal completes.
+			copyValue := reflect.New(elemValue.Type()).Elem()
+			copyValue.Set(elemValue)
+
+			if err := transformDeepValue(elemPath, copyValue, reflect.StructField{}, visited, fn); err != nil {
+				return err
+			}
+
+			v.SetMapIndex(key, copyValue)
+
+		default:
+			field := Field{
+				Name:  fmt.Sprint(key.Interface()),
+				Value: elemValue.Interface(),
+			}
+
+			update, value := fn(elemPath, field)
+			if !update {
+				continue
+			}
+
+			if value == nil {
+				v.SetMapIndex(key, reflect.Zero(elemValue.Type()))
+				continue
+			}
+
+			valueType := reflect.ValueOf(value).Type()
+			if !valueType.AssignableTo(elemValue.Type()) {
+				return fmt.Errorf(
+					`update field "%s" faild: "%s" is not assignable to "%s"`,
+					field.Name,
+					valueType,
+					elemValue.Type(),
+				)
+			}
+
+			v.SetMapIndex(key, reflect.ValueOf(value))
+		}
+	}
+
+	return nil
+}
+
+func appendPath(path []string, next string) []string {
+	newPath := make([]string, len(path), len(path)+1)
+	copy(newPath, path)
+	return append(newPath, next)
+}
@@ -0,0 +1,102 @@
+package hack
+
+import (
+	"reflect"
+	"testing"
+)
+
+type deepAddress struct {
+	Street string
+}
+
+type deepBase struct {
+	ID int
+}
+
+type deepUser struct {
+	deepBase
+	Name string
+	Addr *deepAddress
+	Tags []string
+}
+
+func TestTransformDeep(t *testing.T) {
+	u := deepUser{
+		deepBase: deepBase{ID: 1},
+		Name:     "bob",
+		Addr:     &deepAddress{Street: "Main"},
+		Tags:     []string{"a", "b"},
+	}
+
+	var paths [][]string
+
+	err := TransformDeep(&u, func(path []string, f Field) (bool, interface{}) {
+		paths = append(paths, append([]string{}, path...))
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("TransformDeep returned error: %v", err)
+	}
+
+	want := [][]string{
+		{"ID"},
+		{"Name"},
+		{"Addr", "Street"},
+		{"Tags", "0"},
+		{"Tags", "1"},
+	}
+
+	if len(paths) != len(want) {
+		t.Fatalf("expected %d leaves, got %d: %v", len(want), len(paths), paths)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(paths[i], want[i]) {
+			t.Errorf("path %d: expected %v, got %v", i, want[i], paths[i])
+		}
+	}
+}
+
+func TestTransformDeepUpdatesNestedField(t *testing.T) {
+	u := deepUser{Addr: &deepAddress{Street: "Main"}}
+
+	err := TransformDeep(&u, func(path []string, f Field) (bool, interface{}) {
+		if len(path) == 2 && path[0] == "Addr" && path[1] == "Street" {
+			return true, "Second"
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("TransformDeep returned error: %v", err)
+	}
+
+	if u.Addr.Street != "Second" {
+		t.Errorf("expected nested field to be updated, got %q", u.Addr.Street)
+	}
+}
+
+type cyclicNode struct {
+	Name string
+	Next *cyclicNode
+}
+
+type cyclicHolder struct {
+	Node *cyclicNode
+}
+
+func TestTransformDeepHandlesCycles(t *testing.T) {
+	n := &cyclicNode{Name: "n"}
+	n.Next = n
+	holder := &cyclicHolder{Node: n}
+
+	visits := 0
+	err := TransformDeep(holder, func(path []string, f Field) (bool, interface{}) {
+		visits++
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("TransformDeep returned error: %v", err)
+	}
+	if visits != 1 {
+		t.Errorf("expected the self-loop to be visited once, got %d", visits)
+	}
+}